@@ -1,3 +1,16 @@
 module github.com/buraglio/ipv6perftest
 
 go 1.24.3
+
+require (
+	github.com/quic-go/quic-go v0.57.0
+	golang.org/x/net v0.50.0
+	golang.org/x/time v0.12.0
+)
+
+require (
+	github.com/quic-go/qpack v0.6.0 // indirect
+	golang.org/x/crypto v0.48.0 // indirect
+	golang.org/x/sys v0.41.0 // indirect
+	golang.org/x/text v0.34.0 // indirect
+)